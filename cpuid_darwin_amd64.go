@@ -0,0 +1,42 @@
+/*
+ * Minio Cloud Storage, (C) 2016 Minio, Inc.
+ * Aidos Developer, 2017
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sha256
+
+import "golang.org/x/sys/unix"
+
+// Package-level feature flags consulted by Block/block to pick the
+// fastest available backend. Darwin doesn't expose /proc/cpuinfo, so we
+// ask the kernel directly via sysctl instead of the cpuid-instruction
+// probing the Linux build uses.
+var (
+	avx2  = sysctlBool("hw.optional.avx2_0")
+	avx   = sysctlBool("hw.optional.avx1_0")
+	ssse3 = sysctlBool("hw.optional.supplementalsse3")
+)
+
+// armSha is always false on amd64; it only applies to the ARMv8 SHA
+// extension backend.
+const armSha = false
+
+func sysctlBool(name string) bool {
+	v, err := unix.SysctlUint32(name)
+	if err != nil {
+		return false
+	}
+	return v != 0
+}
@@ -0,0 +1,41 @@
+/*
+ * Minio Cloud Storage, (C) 2016 Minio, Inc.
+ * Aidos Developer, 2017
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sha256
+
+import "golang.org/x/sys/unix"
+
+// armSha reports whether the ARMv8.2 SHA256 crypto extension
+// (FEAT_SHA256) is available. Every Apple Silicon chip shipped so far
+// has it, but we still probe rather than assume, since hw.optional.*
+// is the documented way to check for an optional ARM feature on Darwin.
+var armSha = sysctlBool("hw.optional.arm.FEAT_SHA256")
+
+// avx2, avx and ssse3 are x86-only backends; they never apply on arm64.
+const (
+	avx2  = false
+	avx   = false
+	ssse3 = false
+)
+
+func sysctlBool(name string) bool {
+	v, err := unix.SysctlUint32(name)
+	if err != nil {
+		return false
+	}
+	return v != 0
+}
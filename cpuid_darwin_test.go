@@ -0,0 +1,60 @@
+/*
+ * Minio Cloud Storage, (C) 2016 Minio, Inc.
+ * Aidos Developer, 2017
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sha256
+
+import (
+	"crypto/sha256"
+	"testing"
+)
+
+// TestDarwinBackendMatchesStdlib checks that whichever backend New()
+// picks on this machine - chosen from the detected AVX2/AVX/SSSE3/ARM
+// SHA feature level - produces the same digest as the standard library.
+func TestDarwinBackendMatchesStdlib(t *testing.T) {
+	cases := []struct {
+		name string
+		data []byte
+	}{
+		{"empty", nil},
+		{"short", []byte("Aidos Kuneen")},
+		{"oneBlock", make([]byte, BlockSize)},
+		{"multiBlock", make([]byte, 3*BlockSize+17)},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got := Sum256(c.data)
+			want := sha256.Sum256(c.data)
+			if got != want {
+				t.Fatalf("Sum256(%s) = %x, want %x", c.name, got, want)
+			}
+
+			h := New()
+			if _, err := h.Write(c.data); err != nil {
+				t.Fatalf("Write: %v", err)
+			}
+			var sum [Size]byte
+			copy(sum[:], h.Sum(nil))
+			if sum != want {
+				t.Fatalf("New().Sum(%s) = %x, want %x", c.name, sum, want)
+			}
+		})
+	}
+
+	t.Logf("detected backend: avx2=%v avx=%v ssse3=%v armSha=%v", avx2, avx, ssse3, armSha)
+}
@@ -0,0 +1,72 @@
+/*
+ * Minio Cloud Storage, (C) 2016 Minio, Inc.
+ * Aidos Developer, 2017
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sha256
+
+import (
+	"crypto/sha256"
+	"testing"
+)
+
+func TestSumFixed(t *testing.T) {
+	// Cover both sides of the 55/56-byte single-vs-two-block boundary
+	// and the 119-byte ceiling.
+	for _, n := range []int{0, 1, 32, 55, 56, 63, 64, 65, 119} {
+		data := make([]byte, n)
+		for i := range data {
+			data[i] = byte(i)
+		}
+		got := SumFixed(data)
+		want := sha256.Sum256(data)
+		if got != want {
+			t.Errorf("SumFixed(%d bytes) = %x, want %x", n, got, want)
+		}
+	}
+}
+
+func TestSumFixedPanicsOnOversizedInput(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatal("SumFixed did not panic on a 120-byte input")
+		}
+	}()
+	SumFixed(make([]byte, 120))
+}
+
+func TestSum256D64(t *testing.T) {
+	data := make([]byte, 64)
+	for i := range data {
+		data[i] = byte(i)
+	}
+	got := Sum256D64(data)
+	want := sha256.Sum256(data)
+	if got != want {
+		t.Errorf("Sum256D64 = %x, want %x", got, want)
+	}
+}
+
+func TestSum256D32(t *testing.T) {
+	data := make([]byte, 32)
+	for i := range data {
+		data[i] = byte(i)
+	}
+	got := Sum256D32(data)
+	want := sha256.Sum256(data)
+	if got != want {
+		t.Errorf("Sum256D32 = %x, want %x", got, want)
+	}
+}
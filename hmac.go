@@ -0,0 +1,97 @@
+/*
+ * Minio Cloud Storage, (C) 2016 Minio, Inc.
+ * Aidos Developer, 2017
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sha256
+
+import "hash"
+
+// hmacDigest implements HMAC-SHA256 directly against the accelerated
+// Block function rather than wrapping crypto/hmac around New(), which
+// would re-pay hash.Hash interface overhead for every 64-byte block.
+// The inner and outer padded-key states are precomputed once in
+// NewHMAC, so Sum only has to finish the inner digest (one block, or two
+// when 56 or more message bytes are still buffered) and fold its 32-byte
+// result into the outer state (one block).
+type hmacDigest struct {
+	inner, outer digest
+	innerState   [8]uint32
+	outerState   [8]uint32
+}
+
+// NewHMAC returns a hash.Hash computing HMAC-SHA256 with the given key.
+func NewHMAC(key []byte) hash.Hash {
+	d := new(hmacDigest)
+
+	var ipad, opad [BlockSize]byte
+	if len(key) > BlockSize {
+		sum := Sum256(key)
+		copy(ipad[:], sum[:])
+		copy(opad[:], sum[:])
+	} else {
+		copy(ipad[:], key)
+		copy(opad[:], key)
+	}
+	for i := range ipad {
+		ipad[i] ^= 0x36
+		opad[i] ^= 0x5c
+	}
+
+	d.innerState = [8]uint32{Init0, Init1, Init2, Init3, Init4, Init5, Init6, Init7}
+	d.outerState = [8]uint32{Init0, Init1, Init2, Init3, Init4, Init5, Init6, Init7}
+	Block(d.innerState[:], ipad[:])
+	Block(d.outerState[:], opad[:])
+
+	d.Reset()
+	return d
+}
+
+// SumHMAC is a one-shot helper computing HMAC-SHA256(key, data).
+func SumHMAC(key, data []byte) [Size]byte {
+	d := NewHMAC(key)
+	_, _ = d.Write(data)
+	var out [Size]byte
+	copy(out[:], d.Sum(nil))
+	return out
+}
+
+func (d *hmacDigest) Reset() {
+	d.inner.h = d.innerState
+	d.inner.nx = 0
+	d.inner.len = BlockSize
+	d.outer.h = d.outerState
+	d.outer.nx = 0
+	d.outer.len = BlockSize
+}
+
+func (d *hmacDigest) Write(p []byte) (int, error) {
+	return d.inner.Write(p)
+}
+
+func (d *hmacDigest) Size() int { return Size }
+
+func (d *hmacDigest) BlockSize() int { return BlockSize }
+
+func (d *hmacDigest) Sum(in []byte) []byte {
+	inner := d.inner
+	innerSum := inner.checkSum()
+
+	outer := d.outer
+	_, _ = outer.Write(innerSum[:])
+	sum := outer.checkSum()
+
+	return append(in, sum[:]...)
+}
@@ -0,0 +1,76 @@
+/*
+ * Minio Cloud Storage, (C) 2016 Minio, Inc.
+ * Aidos Developer, 2017
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sha256
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"testing"
+)
+
+func TestSumHMAC(t *testing.T) {
+	cases := []struct {
+		key, data []byte
+	}{
+		{[]byte("key"), []byte("The quick brown fox jumps over the lazy dog")},
+		{make([]byte, BlockSize), []byte("short")},
+		{make([]byte, BlockSize+17), bytes.Repeat([]byte("x"), 200)},
+		{nil, []byte("empty key")},
+	}
+
+	for _, c := range cases {
+		got := SumHMAC(c.key, c.data)
+
+		ref := hmac.New(sha256.New, c.key)
+		ref.Write(c.data)
+		var want [Size]byte
+		copy(want[:], ref.Sum(nil))
+
+		if got != want {
+			t.Errorf("SumHMAC(%x, %x) = %x, want %x", c.key, c.data, got, want)
+		}
+	}
+}
+
+func TestNewHMACWriteInChunks(t *testing.T) {
+	key := []byte("super-secret-key")
+	data := bytes.Repeat([]byte("0123456789"), 20)
+
+	h := NewHMAC(key)
+	for i := 0; i < len(data); i += 7 {
+		end := i + 7
+		if end > len(data) {
+			end = len(data)
+		}
+		if _, err := h.Write(data[i:end]); err != nil {
+			t.Fatalf("Write: %v", err)
+		}
+	}
+	var got [Size]byte
+	copy(got[:], h.Sum(nil))
+
+	ref := hmac.New(sha256.New, key)
+	ref.Write(data)
+	var want [Size]byte
+	copy(want[:], ref.Sum(nil))
+
+	if got != want {
+		t.Errorf("NewHMAC chunked write = %x, want %x", got, want)
+	}
+}
@@ -0,0 +1,68 @@
+/*
+ * Minio Cloud Storage, (C) 2016 Minio, Inc.
+ * Aidos Developer, 2017
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sha256
+
+import (
+	"bytes"
+	"encoding"
+	"testing"
+)
+
+// TestMarshalUnmarshalResumesHashing checks that a digest checkpointed
+// with MarshalBinary partway through a message, then restored with
+// UnmarshalBinary, produces the same sum as hashing the whole message
+// in one go.
+func TestMarshalUnmarshalResumesHashing(t *testing.T) {
+	part1 := bytes.Repeat([]byte("a"), 130)
+	part2 := bytes.Repeat([]byte("b"), 77)
+
+	var want digest
+	want.Reset()
+	_, _ = want.Write(part1)
+	_, _ = want.Write(part2)
+	wantSum := want.checkSum()
+
+	var d digest
+	d.Reset()
+	_, _ = d.Write(part1)
+
+	state, err := d.MarshalBinary()
+	if err != nil {
+		t.Fatalf("MarshalBinary: %v", err)
+	}
+
+	var resumed digest
+	if err := resumed.UnmarshalBinary(state); err != nil {
+		t.Fatalf("UnmarshalBinary: %v", err)
+	}
+	_, _ = resumed.Write(part2)
+	gotSum := resumed.checkSum()
+
+	if gotSum != wantSum {
+		t.Errorf("resumed sum = %x, want %x", gotSum, wantSum)
+	}
+
+	var _ encoding.BinaryMarshaler = &digest{}
+	var _ encoding.BinaryUnmarshaler = &digest{}
+}
+
+func TestUnmarshalBinaryRejectsBadState(t *testing.T) {
+	if err := new(digest).UnmarshalBinary([]byte("not a digest")); err == nil {
+		t.Fatal("UnmarshalBinary accepted garbage input")
+	}
+}
@@ -20,12 +20,16 @@ package sha256
 import (
 	"crypto/sha256"
 	"encoding/binary"
+	"errors"
 	"hash"
 )
 
 // Size - The size of a SHA256 checksum in bytes.
 const Size = 32
 
+// Size224 - The size of a SHA224 checksum in bytes.
+const Size224 = 28
+
 // BlockSize - The blocksize of SHA256 in bytes.
 const BlockSize = 64
 
@@ -45,24 +49,48 @@ const (
 	Init7 = 0x5BE0CD19
 )
 
+//initial values for SHA-224
+const (
+	Init0_224 = 0xC1059ED8
+	Init1_224 = 0x367CD507
+	Init2_224 = 0x3070DD17
+	Init3_224 = 0xF70E5939
+	Init4_224 = 0xFFC00B31
+	Init5_224 = 0x68581511
+	Init6_224 = 0x64F98FA7
+	Init7_224 = 0xBEFA4FA4
+)
+
 // digest represents the partial evaluation of a checksum.
 type digest struct {
-	h   [8]uint32
-	x   [chunk]byte
-	nx  int
-	len uint64
+	h     [8]uint32
+	x     [chunk]byte
+	nx    int
+	len   uint64
+	is224 bool
 }
 
 // Reset digest back to default
 func (d *digest) Reset() {
-	d.h[0] = Init0
-	d.h[1] = Init1
-	d.h[2] = Init2
-	d.h[3] = Init3
-	d.h[4] = Init4
-	d.h[5] = Init5
-	d.h[6] = Init6
-	d.h[7] = Init7
+	if d.is224 {
+		d.h[0] = Init0_224
+		d.h[1] = Init1_224
+		d.h[2] = Init2_224
+		d.h[3] = Init3_224
+		d.h[4] = Init4_224
+		d.h[5] = Init5_224
+		d.h[6] = Init6_224
+		d.h[7] = Init7_224
+	} else {
+		d.h[0] = Init0
+		d.h[1] = Init1
+		d.h[2] = Init2
+		d.h[3] = Init3
+		d.h[4] = Init4
+		d.h[5] = Init5
+		d.h[6] = Init6
+		d.h[7] = Init7
+	}
 	d.nx = 0
 	d.len = 0
 }
@@ -117,6 +145,19 @@ func New() hash.Hash {
 	return sha256.New()
 }
 
+// New224 returns a new hash.Hash computing the SHA224 checksum.
+func New224() hash.Hash {
+	if avx2 || avx || ssse3 || armSha {
+		d := new(digest)
+		d.is224 = true
+		d.Reset()
+		return d
+	}
+	// Fallback to the standard golang implementation
+	// if no features were found.
+	return sha256.New224()
+}
+
 // Sum256 - single caller sha256 helper
 func Sum256(data []byte) [Size]byte {
 	var d digest
@@ -125,6 +166,18 @@ func Sum256(data []byte) [Size]byte {
 	return d.checkSum()
 }
 
+// Sum224 - single caller sha224 helper
+func Sum224(data []byte) [Size224]byte {
+	var d digest
+	d.is224 = true
+	d.Reset()
+	d.Write(data)
+	sum := d.checkSum()
+	var out [Size224]byte
+	copy(out[:], sum[:Size224])
+	return out
+}
+
 //Sum256D32 returns sha256 of 256 bytes data.
 func Sum256D32(data []byte) [Size]byte {
 	stat := []uint32{
@@ -148,8 +201,75 @@ func Sum256D32(data []byte) [Size]byte {
 	return out
 }
 
+//Sum256D64 returns sha256 of 64 bytes data, the shape of a Merkle-tree
+//parent node built from two 32-byte leaves.
+func Sum256D64(data []byte) [Size]byte {
+	stat := []uint32{
+		Init0,
+		Init1,
+		Init2,
+		Init3,
+		Init4,
+		Init5,
+		Init6,
+		Init7,
+	}
+	var buf [128]byte
+	copy(buf[:], data)
+	buf[64] = 0x80
+	buf[126] = 0x02
+	// buf[127] = 0x00
+	Block(stat, buf[:64])
+	Block(stat, buf[64:])
+	var out [Size]byte
+	Int2Bytes(stat, out[:])
+	return out
+}
+
+//SumFixed returns sha256 of data whose length is known to be no more
+//than 119 bytes, inlining the padding into a stack buffer instead of
+//allocating and driving a digest. Inputs up to 55 bytes take a single
+//Block call; 56..119 bytes take two, mirroring Sum256D32/Sum256D64 but
+//for any length in that range.
+func SumFixed(data []byte) [Size]byte {
+	n := len(data)
+	if n > 119 {
+		panic("sha256: SumFixed called with data longer than 119 bytes")
+	}
+	stat := []uint32{
+		Init0,
+		Init1,
+		Init2,
+		Init3,
+		Init4,
+		Init5,
+		Init6,
+		Init7,
+	}
+	bitLen := uint64(n) << 3
+	var buf [128]byte
+	copy(buf[:], data)
+	buf[n] = 0x80
+	if n <= 55 {
+		binary.BigEndian.PutUint64(buf[56:64], bitLen)
+		Block(stat, buf[:64])
+	} else {
+		binary.BigEndian.PutUint64(buf[120:128], bitLen)
+		Block(stat, buf[:64])
+		Block(stat, buf[64:128])
+	}
+	var out [Size]byte
+	Int2Bytes(stat, out[:])
+	return out
+}
+
 // Return size of checksum
-func (d *digest) Size() int { return Size }
+func (d *digest) Size() int {
+	if d.is224 {
+		return Size224
+	}
+	return Size
+}
 
 // Return blocksize of checksum
 func (d *digest) BlockSize() int { return BlockSize }
@@ -183,6 +303,9 @@ func (d *digest) Sum(in []byte) []byte {
 	// Make a copy of d0 so that caller can keep writing and summing.
 	d0 := *d
 	hash := d0.checkSum()
+	if d0.is224 {
+		return append(in, hash[:Size224]...)
+	}
 	return append(in, hash[:]...)
 }
 
@@ -221,3 +344,68 @@ func (d *digest) checkSum() [Size]byte {
 
 	return digest
 }
+
+const (
+	magic224      = "sha\x02"
+	magic256      = "sha\x03"
+	marshaledSize = len(magic256) + 8*4 + chunk + 8
+)
+
+// MarshalBinary encodes the digest's internal state so hashing can be
+// resumed later, matching the encoding.BinaryMarshaler contract that
+// crypto/sha256 implements.
+func (d *digest) MarshalBinary() ([]byte, error) {
+	b := make([]byte, 0, marshaledSize)
+	if d.is224 {
+		b = append(b, magic224...)
+	} else {
+		b = append(b, magic256...)
+	}
+	for _, s := range d.h {
+		b = appendUint32(b, s)
+	}
+	b = append(b, d.x[:d.nx]...)
+	b = append(b, make([]byte, len(d.x)-d.nx)...)
+	b = appendUint64(b, d.len)
+	return b, nil
+}
+
+// UnmarshalBinary restores a digest previously serialized with
+// MarshalBinary.
+func (d *digest) UnmarshalBinary(b []byte) error {
+	if len(b) < len(magic224) || (string(b[:len(magic224)]) != magic224 && string(b[:len(magic256)]) != magic256) {
+		return errors.New("sha256: invalid hash state identifier")
+	}
+	if len(b) != marshaledSize {
+		return errors.New("sha256: invalid hash state size")
+	}
+	d.is224 = string(b[:len(magic224)]) == magic224
+	b = b[len(magic256):]
+	for i := range d.h {
+		d.h[i], b = consumeUint32(b)
+	}
+	b = b[copy(d.x[:], b[:chunk]):]
+	d.len, b = consumeUint64(b)
+	d.nx = int(d.len % chunk)
+	return nil
+}
+
+func appendUint64(b []byte, x uint64) []byte {
+	var a [8]byte
+	binary.BigEndian.PutUint64(a[:], x)
+	return append(b, a[:]...)
+}
+
+func appendUint32(b []byte, x uint32) []byte {
+	var a [4]byte
+	binary.BigEndian.PutUint32(a[:], x)
+	return append(b, a[:]...)
+}
+
+func consumeUint64(b []byte) (uint64, []byte) {
+	return binary.BigEndian.Uint64(b[0:8]), b[8:]
+}
+
+func consumeUint32(b []byte) (uint32, []byte) {
+	return binary.BigEndian.Uint32(b[0:4]), b[4:]
+}
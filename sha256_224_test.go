@@ -0,0 +1,60 @@
+/*
+ * Minio Cloud Storage, (C) 2016 Minio, Inc.
+ * Aidos Developer, 2017
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sha256
+
+import (
+	"crypto/sha256"
+	"testing"
+)
+
+func TestSum224(t *testing.T) {
+	cases := [][]byte{
+		nil,
+		[]byte("abc"),
+		[]byte("Aidos Kuneen"),
+		make([]byte, BlockSize),
+		make([]byte, 3*BlockSize+17),
+	}
+
+	for _, data := range cases {
+		got := Sum224(data)
+		want := sha256.Sum224(data)
+		if got != want {
+			t.Errorf("Sum224(%x) = %x, want %x", data, got, want)
+		}
+	}
+}
+
+func TestNew224(t *testing.T) {
+	data := []byte("the quick brown fox jumps over the lazy dog")
+
+	h := New224()
+	if _, err := h.Write(data); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+	if got, want := h.Size(), Size224; got != want {
+		t.Errorf("Size() = %d, want %d", got, want)
+	}
+
+	var got [Size224]byte
+	copy(got[:], h.Sum(nil))
+	want := sha256.Sum224(data)
+	if got != want {
+		t.Errorf("New224().Sum() = %x, want %x", got, want)
+	}
+}